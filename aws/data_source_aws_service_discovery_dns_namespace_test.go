@@ -0,0 +1,53 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDataSourceAwsServiceDiscoveryDnsNamespace_basic(t *testing.T) {
+	dataSourceName := "data.aws_service_discovery_dns_namespace.test"
+	resourceName := "aws_service_discovery_private_dns_namespace.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsServiceDiscoveryDnsNamespaceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "hosted_zone", resourceName, "hosted_zone"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsServiceDiscoveryDnsNamespaceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_service_discovery_private_dns_namespace" "test" {
+  name        = "%[1]s.tf"
+  description = "test"
+  vpc         = aws_vpc.test.id
+}
+
+data "aws_service_discovery_dns_namespace" "test" {
+  name        = aws_service_discovery_private_dns_namespace.test.name
+  hosted_zone = aws_service_discovery_private_dns_namespace.test.hosted_zone
+}
+`, rName)
+}
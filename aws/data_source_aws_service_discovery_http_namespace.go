@@ -0,0 +1,99 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func dataSourceAwsServiceDiscoveryHttpNamespace() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsServiceDiscoveryHttpNamespaceRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceAwsServiceDiscoveryHttpNamespaceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sdconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	name := d.Get("name").(string)
+
+	input := &servicediscovery.ListNamespacesInput{
+		Filters: []*servicediscovery.NamespaceFilter{
+			{
+				Name:      aws.String(servicediscovery.NamespaceFilterNameType),
+				Values:    aws.StringSlice([]string{servicediscovery.NamespaceTypeHttp}),
+				Condition: aws.String(servicediscovery.FilterConditionEq),
+			},
+		},
+	}
+
+	var namespaceIDs []string
+	err := conn.ListNamespacesPages(input, func(page *servicediscovery.ListNamespacesOutput, lastPage bool) bool {
+		for _, ns := range page.Namespaces {
+			if aws.StringValue(ns.Name) == name {
+				namespaceIDs = append(namespaceIDs, aws.StringValue(ns.Id))
+			}
+		}
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error listing Service Discovery HTTP Namespaces: %w", err)
+	}
+
+	if len(namespaceIDs) == 0 {
+		return fmt.Errorf("error finding Service Discovery HTTP Namespace (%s): no results found", name)
+	}
+
+	if len(namespaceIDs) > 1 {
+		return fmt.Errorf("error finding Service Discovery HTTP Namespace (%s): multiple results found, try a more specific search criteria", name)
+	}
+
+	namespaceID := namespaceIDs[0]
+
+	resp, err := conn.GetNamespace(&servicediscovery.GetNamespaceInput{
+		Id: aws.String(namespaceID),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading Service Discovery HTTP Namespace (%s): %w", namespaceID, err)
+	}
+
+	arn := aws.StringValue(resp.Namespace.Arn)
+	d.SetId(namespaceID)
+	d.Set("name", resp.Namespace.Name)
+	d.Set("description", resp.Namespace.Description)
+	d.Set("arn", arn)
+
+	tags, err := keyvaluetags.ServicediscoveryListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for resource (%s): %w", arn, err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package waiter
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+// OperationTimeout is the default amount of time to wait for a Service Discovery Operation
+// to complete when a caller does not have a more specific, configurable timeout to pass in.
+const OperationTimeout = 5 * time.Minute
+
+// OperationSuccess waits for the Service Discovery Operation with the given ID to reach the
+// SUCCESS status, bounded by timeout.
+func OperationSuccess(conn *servicediscovery.ServiceDiscovery, operationID string, timeout time.Duration) (*servicediscovery.GetOperationOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{servicediscovery.OperationStatusSubmitted, servicediscovery.OperationStatusPending},
+		Target:  []string{servicediscovery.OperationStatusSuccess},
+		Refresh: statusOperation(conn, operationID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*servicediscovery.GetOperationOutput); ok {
+		if aws.StringValue(output.Operation.Status) == servicediscovery.OperationStatusFail {
+			return output, fmt.Errorf("%s: %s", aws.StringValue(output.Operation.ErrorCode), aws.StringValue(output.Operation.ErrorMessage))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func statusOperation(conn *servicediscovery.ServiceDiscovery, operationID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.GetOperation(&servicediscovery.GetOperationInput{
+			OperationId: aws.String(operationID),
+		})
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil || output.Operation == nil {
+			return nil, "", nil
+		}
+
+		return output, aws.StringValue(output.Operation.Status), nil
+	}
+}
@@ -0,0 +1,20 @@
+package aws
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// Provider returns a terraform.ResourceProvider.
+func Provider() *schema.Provider {
+	return &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"aws_service_discovery_http_namespace":        resourceAwsServiceDiscoveryHttpNamespace(),
+			"aws_service_discovery_private_dns_namespace": resourceAwsServiceDiscoveryPrivateDnsNamespace(),
+		},
+
+		DataSourcesMap: map[string]*schema.Resource{
+			"aws_service_discovery_dns_namespace":  dataSourceAwsServiceDiscoveryDnsNamespace(),
+			"aws_service_discovery_http_namespace": dataSourceAwsServiceDiscoveryHttpNamespace(),
+		},
+	}
+}
@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/servicediscovery"
@@ -39,6 +40,20 @@ func resourceAwsServiceDiscoveryHttpNamespace() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"creator_request_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"force_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 	}
 }
@@ -48,17 +63,42 @@ func resourceAwsServiceDiscoveryHttpNamespaceCreate(d *schema.ResourceData, meta
 
 	name := d.Get("name").(string)
 
+	// Generated once and reused across retries of the CreateHttpNamespace call below so a
+	// retried request is recognized by the API as the same request rather than creating a
+	// second namespace. Namespace names are not guaranteed unique, so this must stay random
+	// per Create invocation rather than derived from name - a deterministic value would let
+	// two distinct namespaces sharing a name collide on the same CreatorRequestId.
+	creatorRequestID := resource.UniqueId()
+
 	input := &servicediscovery.CreateHttpNamespaceInput{
 		Name:             aws.String(name),
 		Tags:             keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().ServicediscoveryTags(),
-		CreatorRequestId: aws.String(resource.UniqueId()),
+		CreatorRequestId: aws.String(creatorRequestID),
 	}
 
 	if v, ok := d.GetOk("description"); ok {
 		input.Description = aws.String(v.(string))
 	}
 
-	output, err := conn.CreateHttpNamespace(input)
+	var output *servicediscovery.CreateHttpNamespaceOutput
+	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		var err error
+		output, err = conn.CreateHttpNamespace(input)
+
+		if isAWSErr(err, servicediscovery.ErrCodeDuplicateRequest, "") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		output, err = conn.CreateHttpNamespace(input)
+	}
 
 	if err != nil {
 		return fmt.Errorf("error creating Service Discovery HTTP Namespace (%s): %w", name, err)
@@ -68,7 +108,7 @@ func resourceAwsServiceDiscoveryHttpNamespaceCreate(d *schema.ResourceData, meta
 		return fmt.Errorf("error creating Service Discovery HTTP Namespace (%s): creation response missing Operation ID", name)
 	}
 
-	operationOutput, err := waiter.OperationSuccess(conn, aws.StringValue(output.OperationId))
+	operationOutput, err := waiter.OperationSuccess(conn, aws.StringValue(output.OperationId), d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		return fmt.Errorf("error waiting for Service Discovery HTTP Namespace (%s) creation: %w", name, err)
@@ -110,6 +150,7 @@ func resourceAwsServiceDiscoveryHttpNamespaceRead(d *schema.ResourceData, meta i
 	d.Set("name", resp.Namespace.Name)
 	d.Set("description", resp.Namespace.Description)
 	d.Set("arn", arn)
+	d.Set("creator_request_id", resp.Namespace.CreatorRequestId)
 
 	tags, err := keyvaluetags.ServicediscoveryListTags(conn, arn)
 
@@ -140,6 +181,15 @@ func resourceAwsServiceDiscoveryHttpNamespaceUpdate(d *schema.ResourceData, meta
 func resourceAwsServiceDiscoveryHttpNamespaceDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).sdconn
 
+	deleteTimeout := d.Timeout(schema.TimeoutDelete)
+
+	if d.Get("force_destroy").(bool) {
+		deadline := time.Now().Add(deleteTimeout)
+		if err := deleteServiceDiscoveryNamespaceServices(conn, d.Id(), deadline); err != nil {
+			return fmt.Errorf("error removing Service Discovery Services for HTTP Namespace (%s): %w", d.Id(), err)
+		}
+	}
+
 	input := &servicediscovery.DeleteNamespaceInput{
 		Id: aws.String(d.Id()),
 	}
@@ -155,10 +205,107 @@ func resourceAwsServiceDiscoveryHttpNamespaceDelete(d *schema.ResourceData, meta
 	}
 
 	if output != nil && output.OperationId != nil {
-		if _, err := waiter.OperationSuccess(conn, aws.StringValue(output.OperationId)); err != nil {
+		if _, err := waiter.OperationSuccess(conn, aws.StringValue(output.OperationId), deleteTimeout); err != nil {
 			return fmt.Errorf("error waiting for Service Discovery HTTP Namespace (%s) deletion: %w", d.Id(), err)
 		}
 	}
 
 	return nil
 }
+
+// deleteServiceDiscoveryNamespaceServices deregisters every instance of, and deletes, each
+// Service Discovery Service that still belongs to the given namespace. This allows a namespace
+// with dependent services to be destroyed in one operation instead of requiring the caller to
+// remove those services by hand first. Each Operation wait is bounded by the time remaining
+// until deadline so that cleaning up many services cannot run well past the resource's
+// configured "delete" timeout.
+func deleteServiceDiscoveryNamespaceServices(conn *servicediscovery.ServiceDiscovery, namespaceID string, deadline time.Time) error {
+	var serviceIDs []string
+
+	listInput := &servicediscovery.ListServicesInput{
+		Filters: []*servicediscovery.ServiceFilter{
+			{
+				Name:      aws.String(servicediscovery.ServiceFilterNameNamespaceId),
+				Values:    aws.StringSlice([]string{namespaceID}),
+				Condition: aws.String(servicediscovery.FilterConditionEq),
+			},
+		},
+	}
+
+	err := conn.ListServicesPages(listInput, func(page *servicediscovery.ListServicesOutput, lastPage bool) bool {
+		for _, service := range page.Services {
+			serviceIDs = append(serviceIDs, aws.StringValue(service.Id))
+		}
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error listing Service Discovery Services: %w", err)
+	}
+
+	for _, serviceID := range serviceIDs {
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return fmt.Errorf("timeout while removing Service Discovery Services from namespace (%s)", namespaceID)
+		}
+
+		if err := deregisterServiceDiscoveryServiceInstances(conn, serviceID, deadline); err != nil {
+			return fmt.Errorf("error deregistering instances for Service Discovery Service (%s): %w", serviceID, err)
+		}
+
+		if _, err := conn.DeleteService(&servicediscovery.DeleteServiceInput{
+			Id: aws.String(serviceID),
+		}); err != nil && !isAWSErr(err, servicediscovery.ErrCodeServiceNotFound, "") {
+			return fmt.Errorf("error deleting Service Discovery Service (%s): %w", serviceID, err)
+		}
+	}
+
+	return nil
+}
+
+func deregisterServiceDiscoveryServiceInstances(conn *servicediscovery.ServiceDiscovery, serviceID string, deadline time.Time) error {
+	var instanceIDs []string
+
+	listInput := &servicediscovery.ListInstancesInput{
+		ServiceId: aws.String(serviceID),
+	}
+
+	err := conn.ListInstancesPages(listInput, func(page *servicediscovery.ListInstancesOutput, lastPage bool) bool {
+		for _, instance := range page.Instances {
+			instanceIDs = append(instanceIDs, aws.StringValue(instance.Id))
+		}
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error listing instances for Service Discovery Service (%s): %w", serviceID, err)
+	}
+
+	for _, instanceID := range instanceIDs {
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return fmt.Errorf("timeout while deregistering instances for Service Discovery Service (%s)", serviceID)
+		}
+
+		output, err := conn.DeregisterInstance(&servicediscovery.DeregisterInstanceInput{
+			ServiceId:  aws.String(serviceID),
+			InstanceId: aws.String(instanceID),
+		})
+
+		if isAWSErr(err, servicediscovery.ErrCodeInstanceNotFound, "") {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error deregistering instance (%s): %w", instanceID, err)
+		}
+
+		if output != nil && output.OperationId != nil {
+			if _, err := waiter.OperationSuccess(conn, aws.StringValue(output.OperationId), remaining); err != nil {
+				return fmt.Errorf("error waiting for instance (%s) deregistration: %w", instanceID, err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccAwsServiceDiscoveryPrivateDnsNamespace_basic(t *testing.T) {
+	resourceName := "aws_service_discovery_private_dns_namespace.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsServiceDiscoveryPrivateDnsNamespaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceDiscoveryPrivateDnsNamespaceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServiceDiscoveryPrivateDnsNamespaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("%s.tf", rName)),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "hosted_zone"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsServiceDiscoveryPrivateDnsNamespaceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).sdconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_service_discovery_private_dns_namespace" {
+			continue
+		}
+
+		_, err := conn.GetNamespace(&servicediscovery.GetNamespaceInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, servicediscovery.ErrCodeNamespaceNotFound, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Service Discovery Private DNS Namespace (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsServiceDiscoveryPrivateDnsNamespaceExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Service Discovery Private DNS Namespace ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).sdconn
+
+		_, err := conn.GetNamespace(&servicediscovery.GetNamespaceInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccServiceDiscoveryPrivateDnsNamespaceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_service_discovery_private_dns_namespace" "test" {
+  name        = "%[1]s.tf"
+  description = "test"
+  vpc         = aws_vpc.test.id
+}
+`, rName)
+}